@@ -25,6 +25,7 @@ import (
 
 	acc_util "github.com/openshift-online/ocm-cli/pkg/account"
 	"github.com/openshift-online/ocm-cli/pkg/config"
+	"github.com/openshift-online/ocm-cli/pkg/ocm"
 	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 )
 
@@ -106,7 +107,7 @@ func run(cmd *cobra.Command, argv []string) error {
 	searchQuery := ""
 
 	if args.org != "" {
-		searchQuery = fmt.Sprintf("organization_id='%s'", args.org)
+		searchQuery = fmt.Sprintf("organization_id=%s", ocm.QuoteSearchValue(args.org))
 	}
 	// Organization to search in case one was not provided:
 	if args.org == "" && len(args.roles) == 0 {
@@ -123,7 +124,7 @@ func run(cmd *cobra.Command, argv []string) error {
 		args.org = userOrg.ID()
 
 		// Format search request:
-		searchQuery = fmt.Sprintf("organization_id='%s'", args.org)
+		searchQuery = fmt.Sprintf("organization_id=%s", ocm.QuoteSearchValue(args.org))
 	}
 
 	// Print top.
@@ -62,7 +62,7 @@ func run(cmd *cobra.Command, argv []string) error {
 	state := cluster.State()
 
 	// Fetch metrics from AMS
-	search := fmt.Sprintf("cluster_id = '%s'", clusterID)
+	search := fmt.Sprintf("cluster_id = %s", ocm.QuoteSearchValue(clusterID))
 	subsList, err := connection.AccountsMgmt().V1().Subscriptions().List().Search(search).Send()
 	if err != nil {
 		return fmt.Errorf("Can't retrieve subscriptions: %s", err)
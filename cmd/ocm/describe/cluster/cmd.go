@@ -20,6 +20,12 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"strings"
+	"time"
+
+	// Embed the time zone database, so that --timezone works on systems that don't have it,
+	// like Windows:
+	_ "time/tzdata"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
@@ -27,18 +33,50 @@ import (
 	c "github.com/openshift-online/ocm-cli/pkg/cluster"
 	"github.com/openshift-online/ocm-cli/pkg/dump"
 	"github.com/openshift-online/ocm-cli/pkg/ocm"
+	"github.com/openshift-online/ocm-cli/pkg/output"
 )
 
 var args struct {
-	json   bool
-	output bool
+	json            bool
+	output          bool
+	showEligibility bool
+	timezone        string
+	showAutoscaler  bool
+	machinePools    bool
+	outputFormat    string
+	wide            bool
+	maxWidth        int
+	noTruncate      bool
+	noColor         bool
+	org             string
+	creator         string
+	strict          bool
+}
+
+// descriptionFlags are the flags that change the description of the cluster, so they don't apply to
+// the raw JSON printed with --json.
+var descriptionFlags = []string{
+	"output-format",
+	"wide",
+	"show-eligibility",
+	"timezone",
+	"show-autoscaler",
+	"machine-pools",
+	"max-width",
+	"no-truncate",
+	"no-color",
+	"strict",
 }
 
+// singleClusterFlags are the flags that only apply to the description of a single cluster.
+var singleClusterFlags = append([]string{"output", "json"}, descriptionFlags...)
+
 var Cmd = &cobra.Command{
 	Use:   "cluster [flags] {NAME|ID|EXTERNAL_ID}",
 	Short: "Show details of a cluster",
-	Long:  "Show details of a cluster identified by name, identifier or external identifier",
-	RunE:  run,
+	Long: "Show details of a cluster identified by name, identifier or external identifier, " +
+		"or a summary of the clusters of an organization when the --org flag is used",
+	RunE: run,
 }
 
 func init() {
@@ -56,9 +94,102 @@ func init() {
 		false,
 		"Output the entire JSON structure",
 	)
+	flags.StringVar(
+		&args.outputFormat,
+		"output-format",
+		c.OutputFormatText,
+		fmt.Sprintf("Format of the description, either '%s' or '%s'. The JSON format contains the cluster "+
+			"together with the details that the text format obtains from other resources.",
+			c.OutputFormatText, c.OutputFormatJSON),
+	)
+	flags.BoolVar(
+		&args.wide,
+		"wide",
+		false,
+		"Show extended fields: infrastructure ID, DNS base domain and provision shard ID.",
+	)
+	flags.BoolVar(
+		&args.showEligibility,
+		"show-eligibility",
+		false,
+		"Show whether the cluster can currently be upgraded, hibernated, scaled or deleted. "+
+			"A cluster can be upgraded when it is ready, isn't in limited support and has "+
+			"available upgrades. It can be hibernated when it is ready, doesn't use a hosted "+
+			"control plane and its organization has the 'capability.organization.hibernate_cluster' "+
+			"capability. It can be scaled when it is ready. It can be deleted when it isn't "+
+			"already uninstalling and delete protection is disabled.",
+	)
+	flags.StringVar(
+		&args.timezone,
+		"timezone",
+		"UTC",
+		"Time zone used to display timestamps, either an IANA time zone name or 'local'. "+
+			"It doesn't apply to the JSON output, which always uses UTC.",
+	)
+	flags.BoolVar(
+		&args.showAutoscaler,
+		"show-autoscaler",
+		false,
+		"Show the configuration of the cluster autoscaler.",
+	)
+	flags.BoolVar(
+		&args.machinePools,
+		"machine-pools",
+		false,
+		"Show a summary of the machine pools of the cluster.",
+	)
+	flags.IntVar(
+		&args.maxWidth,
+		"max-width",
+		0,
+		"Truncate lines longer than this width with an ellipsis. Defaults to the width of the "+
+			"terminal, output that isn't sent to a terminal isn't truncated.",
+	)
+	flags.BoolVar(
+		&args.noTruncate,
+		"no-truncate",
+		false,
+		"Don't truncate long lines.",
+	)
+	flags.BoolVar(
+		&args.noColor,
+		"no-color",
+		false,
+		"Don't highlight the cluster state with colors. Colors are also disabled when the output "+
+			"isn't a terminal or the NO_COLOR environment variable is set.",
+	)
+	flags.BoolVar(
+		&args.strict,
+		"strict",
+		false,
+		"Fail when any of the details obtained from other resources, like the subscription, the "+
+			"provision shard, the limited support reasons or the machine pools, can't be retrieved, "+
+			"instead of showing them as not available or leaving them out.",
+	)
+	flags.StringVar(
+		&args.org,
+		"org",
+		"",
+		"Organization identifier. Instead of describing a single cluster, show one line per "+
+			"cluster of the organization, including the user that created it.",
+	)
+	flags.StringVar(
+		&args.creator,
+		"creator",
+		"",
+		"User name or email address of a user. Together with --org, show only the clusters "+
+			"created by that user.",
+	)
 }
 
 func run(cmd *cobra.Command, argv []string) error {
+	if args.org != "" {
+		return runOrganization(cmd, argv)
+	}
+	if args.creator != "" {
+		return fmt.Errorf("The --creator flag can only be used together with --org")
+	}
+
 	// Check that there is exactly one cluster name, identifir or external identifier in the
 	// command line arguments:
 	if len(argv) != 1 {
@@ -83,6 +214,37 @@ func run(cmd *cobra.Command, argv []string) error {
 		os.Exit(1)
 	}
 
+	// The raw JSON isn't rendered as a description, so the flags that change the description can't
+	// be used with it:
+	if args.json {
+		for _, name := range descriptionFlags {
+			if cmd.Flags().Changed(name) {
+				return fmt.Errorf("The --%s flag can't be used together with --json", name)
+			}
+		}
+	}
+
+	if args.outputFormat != c.OutputFormatText && args.outputFormat != c.OutputFormatJSON {
+		return fmt.Errorf("Invalid output format '%s', must be '%s' or '%s'",
+			args.outputFormat, c.OutputFormatText, c.OutputFormatJSON)
+	}
+
+	location, err := parseTimezone(args.timezone)
+	if err != nil {
+		return err
+	}
+
+	if args.maxWidth < 0 {
+		return fmt.Errorf("Max width must be a positive number")
+	}
+	maxWidth := args.maxWidth
+	if maxWidth == 0 {
+		maxWidth = output.TerminalWidth(os.Stdout)
+	}
+	if args.noTruncate {
+		maxWidth = 0
+	}
+
 	// Create the client for the OCM API:
 	connection, err := ocm.NewConnection().Build()
 	if err != nil {
@@ -130,11 +292,64 @@ func run(cmd *cobra.Command, argv []string) error {
 		}
 
 	} else {
-		err = c.PrintClusterDescription(connection, cluster)
+		description, err := c.RenderClusterDescription(connection, cluster, c.DescribeOptions{
+			OutputFormat:     args.outputFormat,
+			Wide:             args.wide,
+			ShowEligibility:  args.showEligibility,
+			Location:         location,
+			ShowAutoscaler:   args.showAutoscaler,
+			ShowMachinePools: args.machinePools,
+			NoColor:          args.noColor,
+			MaxWidth:         maxWidth,
+			Strict:           args.strict,
+		})
 		if err != nil {
 			return err
 		}
+		fmt.Print(description)
+	}
+
+	return nil
+}
+
+// runOrganization shows the summary of the clusters of the organization given with the --org flag.
+func runOrganization(cmd *cobra.Command, argv []string) error {
+	if len(argv) != 0 {
+		return fmt.Errorf("Cluster name, identifier or external identifier can't be used " +
+			"together with --org")
+	}
+
+	// The summary has a fixed format, so the flags that change the description of a single
+	// cluster can't be used:
+	for _, name := range singleClusterFlags {
+		if cmd.Flags().Changed(name) {
+			return fmt.Errorf("The --%s flag can't be used together with --org", name)
+		}
 	}
 
+	// Create the client for the OCM API:
+	connection, err := ocm.NewConnection().Build()
+	if err != nil {
+		return fmt.Errorf("Failed to create OCM connection: %v", err)
+	}
+	defer connection.Close()
+
+	description, err := c.RenderOrganizationClusters(connection, args.org, args.creator)
+	if err != nil {
+		return err
+	}
+	fmt.Print(description)
 	return nil
 }
+
+// parseTimezone converts the value of the --timezone flag into a location.
+func parseTimezone(timezone string) (*time.Location, error) {
+	if strings.EqualFold(timezone, "local") {
+		return time.Local, nil
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid time zone '%s': %v", timezone, err)
+	}
+	return location, nil
+}
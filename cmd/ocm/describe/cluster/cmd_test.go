@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Parse time zone", func() {
+	DescribeTable("returns the location",
+		func(timezone string, expected *time.Location) {
+			location, err := parseTimezone(timezone)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(location).To(Equal(expected))
+		},
+		Entry("UTC", "UTC", time.UTC),
+		Entry("local", "local", time.Local),
+		Entry("local in upper case", "LOCAL", time.Local),
+	)
+
+	It("loads IANA time zones", func() {
+		location, err := parseTimezone("Europe/Madrid")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(location.String()).To(Equal("Europe/Madrid"))
+	})
+
+	It("fails for an unknown time zone", func() {
+		_, err := parseTimezone("Mars/Olympus_Mons")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Invalid time zone 'Mars/Olympus_Mons'"))
+	})
+})
@@ -129,7 +129,8 @@ func run(cmd *cobra.Command, argv []string) error {
 
 	// If there is a parameter specified, assume its a filter:
 	if len(argv) == 1 && argv[0] != "" {
-		term := fmt.Sprintf("name like '%%%s%%' or id like '%%%s%%'", argv[0], argv[0])
+		pattern := ocm.QuoteSearchValue("%" + argv[0] + "%")
+		term := fmt.Sprintf("name like %s or id like %s", pattern, pattern)
 		searchTerms = append(searchTerms, term)
 	}
 
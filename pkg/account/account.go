@@ -22,6 +22,8 @@ import (
 
 	"github.com/openshift-online/ocm-sdk-go"
 	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+
+	"github.com/openshift-online/ocm-cli/pkg/ocm"
 )
 
 // GetRolesFromUsers gets all roles a specific user possesses.
@@ -43,7 +45,7 @@ func GetRolesFromUsers(accounts []*amv1.Account,
 		if i > 0 {
 			fmt.Fprintf(ids, ", ")
 		}
-		fmt.Fprintf(ids, "'%s'", account.ID())
+		fmt.Fprint(ids, ocm.QuoteSearchValue(account.ID()))
 	}
 	query := fmt.Sprintf("account_id in (%s)", ids)
 
@@ -29,6 +29,8 @@ import (
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift-online/ocm-cli/pkg/ocm"
 )
 
 const (
@@ -159,9 +161,9 @@ type AddOnItem struct {
 }
 
 type lmtSprReasonItem struct {
-	ID      string
-	Summary string
-	Details string
+	ID      string `json:"id"`
+	Summary string `json:"summary"`
+	Details string `json:"details,omitempty"`
 }
 
 // Regular expression to used to make sure that the identifier or name given by the user is
@@ -178,9 +180,10 @@ func GetCluster(connection *sdk.Connection, key string) (cluster *cmv1.Cluster,
 	clustersResource := connection.ClustersMgmt().V1().Clusters()
 
 	// Try to find a matching subscription:
+	quotedKey := ocm.QuoteSearchValue(key)
 	subsSearch := fmt.Sprintf(
-		"(display_name = '%s' or cluster_id = '%s' or external_cluster_id = '%s')",
-		key, key, key,
+		"(display_name = %s or cluster_id = %s or external_cluster_id = %s)",
+		quotedKey, quotedKey, quotedKey,
 	)
 	subsListResponse, err := subsResource.List().
 		Search(subsSearch).
@@ -233,8 +236,8 @@ func GetCluster(connection *sdk.Connection, key string) (cluster *cmv1.Cluster,
 	// identifier in the accounts management service. To find those clusters we need to check
 	// directly in the clusters management service.
 	clustersSearch := fmt.Sprintf(
-		"id = '%s' or name = '%s' or external_id = '%s'",
-		key, key, key,
+		"id = %s or name = %s or external_id = %s",
+		quotedKey, quotedKey, quotedKey,
 	)
 	clustersListResponse, err := clustersResource.List().
 		Search(clustersSearch).
@@ -17,112 +17,158 @@ limitations under the License.
 package cluster
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
+	"github.com/fatih/color"
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	fmv1 "github.com/openshift-online/ocm-sdk-go/osdfleetmgmt/v1"
 	slv1 "github.com/openshift-online/ocm-sdk-go/servicelogs/v1"
+
+	"github.com/openshift-online/ocm-cli/pkg/ocm"
 )
 
 const (
 	notAvailable string = "N/A"
+
+	// Output formats supported by RenderClusterDescription:
+	OutputFormatText = "text"
+	OutputFormatJSON = "json"
+
+	// ellipsis replaces the end of lines that are truncated.
+	ellipsis = "…"
+
+	// colorReset is the escape sequence that resets the color of the terminal.
+	colorReset = "\x1b[0m"
+
+	// tabWidth is the number of columns between tab stops, used to compute the width of lines.
+	tabWidth = 8
+
+	// manageClusterAdminCapability is the subscription label that allows non-CCS clusters to have
+	// cluster administrators.
+	manageClusterAdminCapability = "capability.cluster.manage_cluster_admin"
+
+	// hibernateClusterCapability is the organization capability that allows clusters to be hibernated.
+	hibernateClusterCapability = "capability.organization.hibernate_cluster"
+
+	// adminIdentityProviderName is the name of the HTPasswd identity provider created to hold the
+	// cluster administrator user.
+	adminIdentityProviderName = "cluster-admin"
 )
 
+// DescribeOptions controls which optional details are included in a cluster description.
+type DescribeOptions struct {
+	// ShowEligibility adds whether common actions can be performed on the cluster.
+	ShowEligibility bool
+
+	// OutputFormat is the format of the description, either 'text' or 'json'. Text is used when it
+	// isn't set.
+	OutputFormat string
+
+	// Location is the time zone used to display timestamps. UTC is used when it isn't set.
+	Location *time.Location
+
+	// ShowAutoscaler adds the configuration of the cluster autoscaler.
+	ShowAutoscaler bool
+
+	// Wide adds the infrastructure identifier, the DNS base domain and the provision shard identifier.
+	Wide bool
+
+	// ShowMachinePools adds a summary of the machine pools of the cluster.
+	ShowMachinePools bool
+
+	// NoColor disables the highlighting of the cluster state. Colors are also disabled when the
+	// standard output isn't a terminal or the NO_COLOR environment variable is set.
+	NoColor bool
+
+	// MaxWidth is the maximum width of the lines of the description. Longer lines are truncated
+	// with an ellipsis. Lines aren't truncated when it is zero.
+	MaxWidth int
+
+	// Strict makes the description fail when any of the details obtained from other resources can't
+	// be retrieved, instead of showing them as not available or leaving them out.
+	Strict bool
+}
+
+// PrintClusterDescription prints the description of the cluster to the standard output.
 func PrintClusterDescription(connection *sdk.Connection, cluster *cmv1.Cluster) error {
+	description, err := RenderClusterDescription(connection, cluster, DescribeOptions{})
+	if err != nil {
+		return err
+	}
+	fmt.Print(description)
+	return nil
+}
+
+// RenderClusterDescription returns the description of the cluster as a string, so that it can be
+// embedded by tools that don't write it directly to the standard output.
+func RenderClusterDescription(connection *sdk.Connection, cluster *cmv1.Cluster,
+	opts DescribeOptions) (string, error) {
+	var b strings.Builder
+
+	location := opts.Location
+	if location == nil {
+		location = time.UTC
+	}
+
 	// Get API URL:
 	api := cluster.API()
 	apiURL, _ := api.GetURL()
 	apiListening := api.Listening()
 
-	// Retrieve the details of the subscription:
-	var sub *amv1.Subscription
-	subID := cluster.Subscription().ID()
-	if subID != "" {
-		subResponse, err := connection.AccountsMgmt().V1().
-			Subscriptions().
-			Subscription(subID).
-			//nolint
-			Get().Parameter("fetchLabels", "true").
-			Send()
-		if err != nil {
-			if subResponse == nil || subResponse.Status() != 404 {
-				return fmt.Errorf(
-					"can't get subscription '%s': %v",
-					subID, err,
-				)
-			}
-		}
-		sub = subResponse.Body()
-	}
-
-	// Retrieve the details of the account:
-	var account *amv1.Account
-	accountID := sub.Creator().ID()
-	if accountID != "" {
-		accountResponse, err := connection.AccountsMgmt().V1().
-			Accounts().
-			Account(accountID).
-			Get().
-			Send()
-		if err != nil {
-			if accountResponse == nil || (accountResponse.Status() != 404 &&
-				accountResponse.Status() != 403) {
-				return fmt.Errorf(
-					"can't get account '%s': %v",
-					accountID, err,
-				)
-			}
-		}
-		account = accountResponse.Body()
+	// Retrieve the details of the subscription and of the account of its creator:
+	sub, err := fetchSubscription(connection, cluster.Subscription().ID())
+	if err != nil {
+		return "", err
 	}
-
-	// Find the details of the creator:
-	organization := notAvailable
-	if account.Organization() != nil && account.Organization().Name() != "" {
-		organization = account.Organization().Name()
+	if sub == nil && cluster.Subscription().ID() != "" && opts.Strict {
+		return "", fmt.Errorf("subscription '%s' doesn't exist or can't be read", cluster.Subscription().ID())
 	}
-
-	creator := account.Username()
-	if creator == "" {
-		creator = notAvailable
+	account, err := fetchAccount(connection, sub.Creator().ID())
+	if err != nil {
+		return "", err
 	}
-
-	email := account.Email()
-	if email == "" {
-		email = notAvailable
+	if account == nil && sub.Creator().ID() != "" && opts.Strict {
+		return "", fmt.Errorf("account '%s' doesn't exist or can't be read", sub.Creator().ID())
 	}
 
-	accountNumber := account.Organization().EbsAccountID()
-	if accountNumber == "" {
-		accountNumber = notAvailable
-	}
+	// Find the details of the creator:
+	owner := newOwnerInfo(sub, account)
 
 	// Find the details of the shard
-	shardPath, err := connection.ClustersMgmt().V1().Clusters().
-		Cluster(cluster.ID()).
-		ProvisionShard().
-		Get().
-		Send()
-	var shard string
-	if shardPath != nil && err == nil {
-		shard = shardPath.Body().HiveConfig().Server()
+	provisionShard, err := findProvisionShard(connection, cluster)
+	if err != nil && opts.Strict {
+		return "", err
 	}
+	shard := provisionShard.HiveConfig().Server()
+	shardID := provisionShard.ID()
 
 	clusterAdminEnabled := false
+	clusterAdminSource := ""
 	if cluster.CCS().Enabled() {
 		clusterAdminEnabled = true
-	} else {
-		for _, label := range sub.Labels() {
-			if label.Key() == "capability.cluster.manage_cluster_admin" &&
-				//nolint
-				label.Value() == "true" {
-				clusterAdminEnabled = true
-			}
+		clusterAdminSource = "CCS"
+	} else if hasLabel(sub, manageClusterAdminCapability) {
+		clusterAdminEnabled = true
+		clusterAdminSource = "capability label"
+	}
+	clusterAdmin := strconv.FormatBool(clusterAdminEnabled)
+	adminIdentityProvider := ""
+	if clusterAdminEnabled {
+		adminIdentityProvider, err = findAdminIdentityProvider(connection, cluster)
+		if err != nil && opts.Strict {
+			return "", err
 		}
+		clusterAdmin = fmt.Sprintf("%t (%s)\n\tAdmin IDP: %s", clusterAdminEnabled, clusterAdminSource,
+			valueOrNotAvailable(adminIdentityProvider))
 	}
 
 	privateLinkEnabled := false
@@ -151,7 +197,74 @@ func PrintClusterDescription(connection *sdk.Connection, cluster *cmv1.Cluster)
 	}
 
 	// Parse Hypershift-related values
-	mgmtClusterName, svcClusterName := findHyperShiftMgmtSvcClusters(connection, cluster)
+	mgmtClusterName, svcClusterName, err := findHyperShiftMgmtSvcClusters(connection, cluster)
+	if err != nil && opts.Strict {
+		return "", err
+	}
+
+	// Marketplace billing info
+	marketplaceAccount := ""
+	var reservedResources []*amv1.ReservedResource
+	if isMarketplaceBilling(cluster) {
+		marketplaceAccount = sub.BillingMarketplaceAccount()
+		reservedResources, err = findReservedResources(connection, cluster.Subscription().ID())
+		if err != nil && opts.Strict {
+			return "", err
+		}
+	}
+
+	// The limited support reasons may not be readable by the user, in that case unless in strict mode
+	// only the status is printed:
+	var limitedSupportReasons []*lmtSprReasonItem
+	if cluster.Status().LimitedSupportReasonCount() > 0 {
+		limitedSupportReasons, err = GetClusterLimitedSupportReasons(connection, cluster.ID())
+		if err != nil && opts.Strict {
+			return "", err
+		}
+	}
+
+	// Optional sections
+	var machinePools []*cmv1.MachinePool
+	if opts.ShowMachinePools {
+		machinePools, err = findMachinePools(connection, cluster)
+		if err != nil && opts.Strict {
+			return "", err
+		}
+	}
+	var autoscaler *cmv1.ClusterAutoscaler
+	if opts.ShowAutoscaler {
+		autoscaler, err = findClusterAutoscaler(connection, cluster)
+		if err != nil && opts.Strict {
+			return "", err
+		}
+	}
+	var eligibilities []actionEligibility
+	if opts.ShowEligibility {
+		eligibilities = evaluateEligibility(cluster, sub)
+	}
+
+	// The JSON description uses the values as returned by the API, so that the ones that aren't
+	// available are omitted instead of set to N/A:
+	if opts.OutputFormat == OutputFormatJSON {
+		return renderClusterDescriptionJSON(cluster, machinePools, autoscaler, clusterDescription{
+			DisplayName:           sub.DisplayName(),
+			Organization:          account.Organization().Name(),
+			Creator:               account.Username(),
+			Email:                 account.Email(),
+			AccountNumber:         account.Organization().EbsAccountID(),
+			ClusterAdmin:          clusterAdminEnabled,
+			ClusterAdminSource:    clusterAdminSource,
+			AdminIdentityProvider: adminIdentityProvider,
+			MarketplaceAccount:    marketplaceAccount,
+			ReservedResources:     newReservedResourceDescriptions(reservedResources),
+			Shard:                 shard,
+			ShardID:               shardID,
+			ManagementCluster:     mgmtClusterName,
+			ServiceCluster:        svcClusterName,
+			LimitedSupportReasons: limitedSupportReasons,
+			Eligibility:           eligibilities,
+		})
+	}
 
 	provisioningStatus := ""
 	if cluster.Status().State() == cmv1.ClusterStateError && cluster.Status().ProvisionErrorCode() != "" {
@@ -162,7 +275,7 @@ func PrintClusterDescription(connection *sdk.Connection, cluster *cmv1.Cluster)
 	}
 
 	// Print short cluster description:
-	fmt.Printf("\n"+
+	fmt.Fprintf(&b, "\n"+
 		"ID:			%s\n"+
 		"External ID:		%s\n"+
 		"Name:			%s\n"+
@@ -173,28 +286,32 @@ func PrintClusterDescription(connection *sdk.Connection, cluster *cmv1.Cluster)
 		cluster.ExternalID(),
 		cluster.Name(),
 		cluster.DomainPrefix(),
-		sub.DisplayName(),
-		cluster.State(),
+		owner.DisplayName,
+		printState(cluster.State(), opts.NoColor),
 		provisioningStatus,
 	)
 
 	if cluster.Status().Description() != "" {
-		fmt.Printf("Details:		%s\n",
+		fmt.Fprintf(&b, "Details:		%s\n",
 			cluster.Status().Description(),
 		)
 	}
 
-	var computesStr string
-	if cluster.Nodes().AutoscaleCompute() != nil {
-		computesStr = fmt.Sprintf("%d-%d (Autoscaled)",
-			cluster.Nodes().AutoscaleCompute().MinReplicas(),
-			cluster.Nodes().AutoscaleCompute().MaxReplicas(),
-		)
-	} else {
-		computesStr = strconv.Itoa(cluster.Nodes().Compute())
+	masterStr := notAvailable
+	infraStr := notAvailable
+	if cluster.Nodes() != nil {
+		masterStr = strconv.Itoa(cluster.Nodes().Master())
+		infraStr = strconv.Itoa(cluster.Nodes().Infra())
 	}
 
-	fmt.Printf("API URL:		%s\n"+
+	// Additional security groups only exist on AWS
+	var controlPlaneSecurityGroups, infraSecurityGroups []string
+	if cluster.CloudProvider().ID() == ProviderAWS {
+		controlPlaneSecurityGroups = cluster.AWS().AdditionalControlPlaneSecurityGroupIds()
+		infraSecurityGroups = cluster.AWS().AdditionalInfraSecurityGroupIds()
+	}
+
+	fmt.Fprintf(&b, "API URL:		%s\n"+
 		"API Listening:		%s\n"+
 		"Console URL:		%s\n"+
 		"Cluster History URL:	%s\n"+
@@ -211,10 +328,10 @@ func PrintClusterDescription(connection *sdk.Connection, cluster *cmv1.Cluster)
 		apiListening,
 		cluster.Console().URL(),
 		fmt.Sprintf("https://cloud.redhat.com/openshift/details/s/%s#clusterHistory", cluster.Subscription().ID()),
-		printNodeInfo(strconv.Itoa(cluster.Nodes().Master()), cluster.AWS().AdditionalControlPlaneSecurityGroupIds()),
-		printNodeInfo(strconv.Itoa(cluster.Nodes().Infra()), cluster.AWS().AdditionalInfraSecurityGroupIds()),
+		printNodeInfo(masterStr, controlPlaneSecurityGroups),
+		printNodeInfo(infraStr, infraSecurityGroups),
 		// To view additional compute SGs customer can use describe machine-pool
-		printNodeInfo(computesStr, []string{}),
+		printNodeInfo(printComputeReplicas(cluster.Nodes()), []string{})+printComputeMachineInfo(cluster.Nodes()),
 		cluster.Product().ID(),
 		cluster.BillingModel(),
 		cluster.CloudProvider().ID(),
@@ -223,38 +340,68 @@ func PrintClusterDescription(connection *sdk.Connection, cluster *cmv1.Cluster)
 		cluster.MultiAZ(),
 	)
 
+	if len(cluster.Nodes().AvailabilityZones()) > 0 {
+		fmt.Fprintf(&b, "Availability Zones:	%s\n", strings.Join(cluster.Nodes().AvailabilityZones(), ", "))
+	}
+
+	// Encryption and compliance info
+	fmt.Fprintf(&b, "Etcd Encryption:	%t\n"+
+		"FIPS:			%t\n",
+		cluster.EtcdEncryption(),
+		cluster.FIPS(),
+	)
+
 	// AWS-specific info
 	if cluster.CloudProvider().ID() == ProviderAWS {
-		fmt.Printf("PrivateLink:		%t\n"+
+		fmt.Fprintf(&b, "PrivateLink:		%t\n"+
 			"STS:			%t\n"+
 			"Subnet IDs:		%s\n",
 			privateLinkEnabled,
 			stsEnabled,
 			cluster.AWS().SubnetIDs(),
 		)
+		if cluster.AWS().EtcdEncryption().KMSKeyARN() != "" {
+			fmt.Fprintf(&b, "Etcd KMS Key ARN:	%s\n", cluster.AWS().EtcdEncryption().KMSKeyARN())
+		}
 	}
 
 	// GCP-specific info
 	if cluster.CloudProvider().ID() == ProviderGCP {
 		if cluster.GCP().Security().SecureBoot() {
-			fmt.Printf("SecureBoot:             %t\n", cluster.GCP().Security().SecureBoot())
+			fmt.Fprintf(&b, "SecureBoot:             %t\n", cluster.GCP().Security().SecureBoot())
 		}
 		if cluster.GCPNetwork().VPCName() != "" {
-			fmt.Printf("VPC-Name:	        %s\n", cluster.GCPNetwork().VPCName())
+			fmt.Fprintf(&b, "VPC-Name:	        %s\n", cluster.GCPNetwork().VPCName())
 		}
 		if cluster.GCPNetwork().ControlPlaneSubnet() != "" {
-			fmt.Printf("Control-Plane-Subnet:   %s\n", cluster.GCPNetwork().ControlPlaneSubnet())
+			fmt.Fprintf(&b, "Control-Plane-Subnet:   %s\n", cluster.GCPNetwork().ControlPlaneSubnet())
 		}
 		if cluster.GCPNetwork().ComputeSubnet() != "" {
-			fmt.Printf("Compute-Subnet:	        %s\n", cluster.GCPNetwork().ComputeSubnet())
+			fmt.Fprintf(&b, "Compute-Subnet:	        %s\n", cluster.GCPNetwork().ComputeSubnet())
+		}
+	}
+
+	// Marketplace billing info
+	if isMarketplaceBilling(cluster) {
+		fmt.Fprintf(&b, "Marketplace Account:	%s\n", valueOrNotAvailable(marketplaceAccount))
+		if len(reservedResources) > 0 {
+			fmt.Fprintf(&b, "Reserved Resources:\n")
+			for _, resource := range reservedResources {
+				fmt.Fprintf(&b, "\t%d x %s/%s (%s)\n",
+					resource.Count(),
+					resource.ResourceType(),
+					resource.ResourceName(),
+					resource.BillingModel(),
+				)
+			}
 		}
 	}
 
-	fmt.Printf("CCS:			%t\n"+
+	fmt.Fprintf(&b, "CCS:			%t\n"+
 		"HCP:			%t\n"+
 		"Existing VPC:		%s\n"+
 		"Channel Group:		%v\n"+
-		"Cluster Admin:		%t\n"+
+		"Cluster Admin:		%s\n"+
 		"Organization:		%s\n"+
 		"Creator:		%s\n"+
 		"Email:			%s\n"+
@@ -264,54 +411,302 @@ func PrintClusterDescription(connection *sdk.Connection, cluster *cmv1.Cluster)
 		cluster.Hypershift().Enabled(),
 		isExistingVPC,
 		cluster.Version().ChannelGroup(),
-		clusterAdminEnabled,
-		organization,
-		creator,
-		email,
-		accountNumber,
-		cluster.CreationTimestamp().Round(time.Second).Format(time.RFC3339Nano),
+		clusterAdmin,
+		owner.Organization,
+		owner.Creator,
+		owner.Email,
+		owner.AccountNumber,
+		cluster.CreationTimestamp().In(location).Round(time.Second).Format(time.RFC3339Nano),
 	)
 
 	expirationTime, hasExpirationTimestamp := cluster.GetExpirationTimestamp()
 	if hasExpirationTimestamp {
-		fmt.Printf("Expiration:		%v\n", expirationTime.Round(time.Second).Format(time.RFC3339Nano))
+		fmt.Fprintf(&b, "Expiration:		%v\n", expirationTime.In(location).Round(time.Second).Format(time.RFC3339Nano))
 	}
 
 	// Hive
 	if shard != "" {
-		fmt.Printf("Shard:			%v\n", shard)
+		fmt.Fprintf(&b, "Shard:			%v\n", shard)
+	}
+
+	// Extended fields
+	if opts.Wide {
+		fmt.Fprintf(&b, "Infra ID:		%s\n"+
+			"DNS Base Domain:	%s\n"+
+			"Shard ID:		%s\n",
+			valueOrNotAvailable(cluster.InfraID()),
+			valueOrNotAvailable(cluster.DNS().BaseDomain()),
+			valueOrNotAvailable(shardID),
+		)
 	}
 
 	// HyperShift (should be mutually exclusive with Hive)
 	if mgmtClusterName != "" {
-		fmt.Printf("Management Cluster:     %s\n", mgmtClusterName)
+		fmt.Fprintf(&b, "Management Cluster:     %s\n", mgmtClusterName)
 	}
 	if svcClusterName != "" {
-		fmt.Printf("Service Cluster:        %s\n", svcClusterName)
+		fmt.Fprintf(&b, "Service Cluster:        %s\n", svcClusterName)
 	}
 
 	// Cluster-wide-proxy
 	if cluster.Proxy().HTTPProxy() != "" {
-		fmt.Printf("HTTPProxy:	        %s\n", cluster.Proxy().HTTPProxy())
+		fmt.Fprintf(&b, "HTTPProxy:	        %s\n", cluster.Proxy().HTTPProxy())
 	}
 	if cluster.Proxy().HTTPSProxy() != "" {
-		fmt.Printf("HTTPSProxy:	        %s\n", cluster.Proxy().HTTPSProxy())
+		fmt.Fprintf(&b, "HTTPSProxy:	        %s\n", cluster.Proxy().HTTPSProxy())
 	}
 	if cluster.Proxy().NoProxy() != "" {
-		fmt.Printf("NoProxy:	        %s\n", cluster.Proxy().NoProxy())
+		fmt.Fprintf(&b, "NoProxy:	        %s\n", cluster.Proxy().NoProxy())
 	}
 	if cluster.AdditionalTrustBundle() != "" {
-		fmt.Printf("AdditionalTrustBundle:  %s\n", cluster.AdditionalTrustBundle())
+		fmt.Fprintf(&b, "AdditionalTrustBundle:  %s\n", cluster.AdditionalTrustBundle())
 	}
 
 	// Limited Support Status
 	if cluster.Status().LimitedSupportReasonCount() > 0 {
-		fmt.Printf("Limited Support:	%t\n", cluster.Status().LimitedSupportReasonCount() > 0)
+		fmt.Fprintf(&b, "Limited Support:	%t\n", cluster.Status().LimitedSupportReasonCount() > 0)
+		for _, reason := range limitedSupportReasons {
+			fmt.Fprintf(&b, "%s\n", printLimitedSupportReason(reason))
+		}
 	}
 
-	fmt.Println()
+	if len(machinePools) > 0 {
+		fmt.Fprintf(&b, "Machine Pools:\n")
+		for _, machinePool := range machinePools {
+			fmt.Fprintf(&b, "%s\n", printMachinePoolInfo(machinePool))
+		}
+	}
 
-	return nil
+	if opts.ShowAutoscaler {
+		fmt.Fprintf(&b, "%s\n", printAutoscalerInfo(autoscaler))
+	}
+
+	if opts.ShowEligibility {
+		fmt.Fprintf(&b, "Eligibility:\n")
+		for _, eligibility := range eligibilities {
+			fmt.Fprintf(&b, "%s\n", printEligibility(eligibility))
+		}
+	}
+
+	fmt.Fprintln(&b)
+
+	return truncateLines(b.String(), opts.MaxWidth), nil
+}
+
+// clusterDescription is the JSON representation of the description of a cluster. It wraps the cluster
+// returned by the API together with the details obtained from other resources, so that none of the
+// information shown in the text output is lost. Details that aren't available are omitted.
+type clusterDescription struct {
+	Cluster               json.RawMessage               `json:"cluster"`
+	DisplayName           string                        `json:"display_name,omitempty"`
+	Organization          string                        `json:"organization,omitempty"`
+	Creator               string                        `json:"creator,omitempty"`
+	Email                 string                        `json:"email,omitempty"`
+	AccountNumber         string                        `json:"account_number,omitempty"`
+	ClusterAdmin          bool                          `json:"cluster_admin"`
+	ClusterAdminSource    string                        `json:"cluster_admin_source,omitempty"`
+	AdminIdentityProvider string                        `json:"admin_identity_provider,omitempty"`
+	MarketplaceAccount    string                        `json:"marketplace_account,omitempty"`
+	ReservedResources     []reservedResourceDescription `json:"reserved_resources,omitempty"`
+	Shard                 string                        `json:"shard,omitempty"`
+	ShardID               string                        `json:"shard_id,omitempty"`
+	ManagementCluster     string                        `json:"management_cluster,omitempty"`
+	ServiceCluster        string                        `json:"service_cluster,omitempty"`
+	LimitedSupportReasons []*lmtSprReasonItem           `json:"limited_support_reasons,omitempty"`
+	MachinePools          json.RawMessage               `json:"machine_pools,omitempty"`
+	Autoscaler            json.RawMessage               `json:"autoscaler,omitempty"`
+	Eligibility           []actionEligibility           `json:"eligibility,omitempty"`
+}
+
+// reservedResourceDescription is the JSON representation of a resource reserved by a marketplace
+// subscription, with the same details shown in the text output.
+type reservedResourceDescription struct {
+	ResourceType string `json:"resource_type"`
+	ResourceName string `json:"resource_name"`
+	BillingModel string `json:"billing_model"`
+	Count        int    `json:"count"`
+}
+
+func newReservedResourceDescriptions(resources []*amv1.ReservedResource) []reservedResourceDescription {
+	var descriptions []reservedResourceDescription
+	for _, resource := range resources {
+		descriptions = append(descriptions, reservedResourceDescription{
+			ResourceType: resource.ResourceType(),
+			ResourceName: resource.ResourceName(),
+			BillingModel: string(resource.BillingModel()),
+			Count:        resource.Count(),
+		})
+	}
+	return descriptions
+}
+
+func renderClusterDescriptionJSON(cluster *cmv1.Cluster, machinePools []*cmv1.MachinePool,
+	autoscaler *cmv1.ClusterAutoscaler, description clusterDescription) (string, error) {
+	buf := new(bytes.Buffer)
+	err := cmv1.MarshalCluster(cluster, buf)
+	if err != nil {
+		return "", fmt.Errorf("can't marshal cluster '%s': %v", cluster.ID(), err)
+	}
+	description.Cluster = buf.Bytes()
+
+	if len(machinePools) > 0 {
+		buf = new(bytes.Buffer)
+		err = cmv1.MarshalMachinePoolList(machinePools, buf)
+		if err != nil {
+			return "", fmt.Errorf("can't marshal machine pools of cluster '%s': %v", cluster.ID(), err)
+		}
+		description.MachinePools = buf.Bytes()
+	}
+
+	if autoscaler != nil {
+		buf = new(bytes.Buffer)
+		err = cmv1.MarshalClusterAutoscaler(autoscaler, buf)
+		if err != nil {
+			return "", fmt.Errorf("can't marshal autoscaler of cluster '%s': %v", cluster.ID(), err)
+		}
+		description.Autoscaler = buf.Bytes()
+	}
+
+	data, err := json.MarshalIndent(description, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("can't marshal description of cluster '%s': %v", cluster.ID(), err)
+	}
+	return string(data) + "\n", nil
+}
+
+// ownerInfo contains the details of the owner of a cluster, obtained from its subscription and from
+// the account of its creator.
+type ownerInfo struct {
+	DisplayName   string
+	Organization  string
+	Creator       string
+	Email         string
+	AccountNumber string
+}
+
+// newOwnerInfo extracts the owner details from the subscription and the account. Any of them can be
+// nil when it couldn't be retrieved, and the values that aren't available are set to N/A.
+func newOwnerInfo(sub *amv1.Subscription, account *amv1.Account) ownerInfo {
+	return ownerInfo{
+		DisplayName:   valueOrNotAvailable(sub.DisplayName()),
+		Organization:  valueOrNotAvailable(account.Organization().Name()),
+		Creator:       valueOrNotAvailable(account.Username()),
+		Email:         valueOrNotAvailable(account.Email()),
+		AccountNumber: valueOrNotAvailable(account.Organization().EbsAccountID()),
+	}
+}
+
+// fetchSubscription retrieves the subscription with the given identifier, including its labels and
+// capabilities. It returns nil without an error when the identifier is empty, or when the subscription
+// doesn't exist or the user isn't allowed to see it, so that callers can show N/A instead.
+func fetchSubscription(conn *sdk.Connection, subID string) (*amv1.Subscription, error) {
+	if subID == "" {
+		return nil, nil
+	}
+	response, err := conn.AccountsMgmt().V1().
+		Subscriptions().
+		Subscription(subID).
+		//nolint
+		Get().Parameter("fetchLabels", "true").
+		Parameter("fetchCapabilities", "true").
+		Send()
+	if err != nil {
+		if response == nil || !isNotFoundOrForbidden(response.Status()) {
+			return nil, fmt.Errorf("can't get subscription '%s': %v", subID, err)
+		}
+		return nil, nil
+	}
+	return response.Body(), nil
+}
+
+// fetchAccount retrieves the account with the given identifier, with the same handling of missing
+// identifiers and of unavailable accounts as fetchSubscription.
+func fetchAccount(conn *sdk.Connection, accountID string) (*amv1.Account, error) {
+	if accountID == "" {
+		return nil, nil
+	}
+	response, err := conn.AccountsMgmt().V1().
+		Accounts().
+		Account(accountID).
+		Get().
+		Send()
+	if err != nil {
+		if response == nil || !isNotFoundOrForbidden(response.Status()) {
+			return nil, fmt.Errorf("can't get account '%s': %v", accountID, err)
+		}
+		return nil, nil
+	}
+	return response.Body(), nil
+}
+
+// isNotFoundOrForbidden checks if the status code means that the object doesn't exist or that the
+// user isn't allowed to see it.
+func isNotFoundOrForbidden(status int) bool {
+	return status == http.StatusNotFound || status == http.StatusForbidden
+}
+
+// hasLabel checks if the subscription has the given label set to true.
+func hasLabel(sub *amv1.Subscription, key string) bool {
+	for _, label := range sub.Labels() {
+		if label.Key() == key &&
+			//nolint
+			label.Value() == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// hasCapability checks if the subscription has the given capability set to true. The capabilities of
+// the subscription include the ones inherited from its organization.
+func hasCapability(sub *amv1.Subscription, capability string) bool {
+	for _, item := range sub.Capabilities() {
+		if item.Name() == capability &&
+			//nolint
+			item.Value() == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+func valueOrNotAvailable(value string) string {
+	if value == "" {
+		return notAvailable
+	}
+	return value
+}
+
+// printComputeReplicas returns the autoscaling range of the compute nodes when autoscaling is enabled,
+// or their fixed number otherwise.
+func printComputeReplicas(nodes *cmv1.ClusterNodes) string {
+	if nodes == nil {
+		return notAvailable
+	}
+	if nodes.AutoscaleCompute() != nil {
+		return fmt.Sprintf("%d-%d (Autoscaled)",
+			nodes.AutoscaleCompute().MinReplicas(),
+			nodes.AutoscaleCompute().MaxReplicas(),
+		)
+	}
+	return strconv.Itoa(nodes.Compute())
+}
+
+// printComputeMachineInfo returns the instance type and root volume size of the compute nodes, omitting
+// the details that the cluster doesn't expose.
+func printComputeMachineInfo(nodes *cmv1.ClusterNodes) string {
+	var info string
+	if nodes.ComputeMachineType().ID() != "" {
+		info += fmt.Sprintf("\n\tInstance Type: %s", nodes.ComputeMachineType().ID())
+	}
+	rootVolumeSize := nodes.ComputeRootVolume().AWS().Size()
+	if rootVolumeSize == 0 {
+		rootVolumeSize = nodes.ComputeRootVolume().GCP().Size()
+	}
+	if rootVolumeSize != 0 {
+		info += fmt.Sprintf("\n\tRoot Volume Size: %d GiB", rootVolumeSize)
+	}
+	return info
 }
 
 func printNodeInfo(replicasInfo string, securityGroups []string) string {
@@ -322,12 +717,201 @@ func printNodeInfo(replicasInfo string, securityGroups []string) string {
 	return nodeStr
 }
 
+func printMachinePoolInfo(machinePool *cmv1.MachinePool) string {
+	replicas := strconv.Itoa(machinePool.Replicas())
+	if machinePool.Autoscaling() != nil {
+		replicas = fmt.Sprintf("%d-%d (Autoscaled)",
+			machinePool.Autoscaling().MinReplicas(),
+			machinePool.Autoscaling().MaxReplicas(),
+		)
+	}
+	availabilityZones := notAvailable
+	if len(machinePool.AvailabilityZones()) > 0 {
+		availabilityZones = strings.Join(machinePool.AvailabilityZones(), ", ")
+	}
+	return fmt.Sprintf("\t%s:\n"+
+		"\t\tInstance Type: %s\n"+
+		"\t\tReplicas: %s\n"+
+		"\t\tAvailability Zones: %s",
+		machinePool.ID(),
+		machinePool.InstanceType(),
+		replicas,
+		availabilityZones,
+	)
+}
+
+func printLimitedSupportReason(reason *lmtSprReasonItem) string {
+	reasonStr := fmt.Sprintf("\t%s", reason.Summary)
+	if reason.Details != "" {
+		reasonStr += fmt.Sprintf("\n\t\t%s", reason.Details)
+	}
+	return reasonStr
+}
+
+// findMachinePools returns the machine pools of the cluster. The user may not have permission to read
+// them, so unless in strict mode callers ignore the error and don't print the section.
+func findMachinePools(conn *sdk.Connection, cluster *cmv1.Cluster) ([]*cmv1.MachinePool, error) {
+	machinePools, err := GetMachinePools(conn.ClustersMgmt().V1().Clusters(), cluster.ID())
+	if err != nil {
+		return nil, fmt.Errorf("can't get machine pools of cluster '%s': %v", cluster.ID(), err)
+	}
+	return machinePools, nil
+}
+
+func printAutoscalerInfo(autoscaler *cmv1.ClusterAutoscaler) string {
+	if autoscaler == nil {
+		return fmt.Sprintf("Cluster Autoscaler:	%s", notAvailable)
+	}
+	return fmt.Sprintf("Cluster Autoscaler:\n"+
+		"\tMax Nodes Total: %d\n"+
+		"\tMax Node Provision Time: %s\n"+
+		"\tBalance Similar Node Groups: %t\n"+
+		"\tScale Down Enabled: %t\n"+
+		"\tScale Down Utilization Threshold: %s\n"+
+		"\tScale Down Unneeded Time: %s\n"+
+		"\tScale Down Delay After Add: %s",
+		autoscaler.ResourceLimits().MaxNodesTotal(),
+		autoscaler.MaxNodeProvisionTime(),
+		autoscaler.BalanceSimilarNodeGroups(),
+		autoscaler.ScaleDown().Enabled(),
+		autoscaler.ScaleDown().UtilizationThreshold(),
+		autoscaler.ScaleDown().UnneededTime(),
+		autoscaler.ScaleDown().DelayAfterAdd(),
+	)
+}
+
+// findClusterAutoscaler returns the autoscaler configured for the cluster, or nil when there is none,
+// which the API reports with a 404. Other errors are returned, and unless in strict mode callers ignore
+// them and show the autoscaler as not available.
+func findClusterAutoscaler(conn *sdk.Connection, cluster *cmv1.Cluster) (*cmv1.ClusterAutoscaler, error) {
+	response, err := conn.ClustersMgmt().V1().Clusters().
+		Cluster(cluster.ID()).
+		Autoscaler().
+		Get().
+		Send()
+	if err != nil {
+		if response != nil && response.Status() == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("can't get autoscaler of cluster '%s': %v", cluster.ID(), err)
+	}
+
+	return response.Body(), nil
+}
+
+// truncateLines truncates the lines of the text that are wider than the given width, replacing their
+// end with an ellipsis. A width of zero or less leaves the text unchanged.
+func truncateLines(text string, width int) string {
+	if width <= 0 {
+		return text
+	}
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = truncateLine(line, width)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func truncateLine(line string, width int) string {
+	if lineWidth(line) <= width {
+		return line
+	}
+	var b strings.Builder
+	column := 0
+	escape := false
+	colored := false
+	for _, r := range line {
+		column, escape = advanceColumn(column, escape, r)
+		if column > width-1 {
+			break
+		}
+		if escape {
+			colored = true
+		}
+		b.WriteRune(r)
+	}
+	if colored {
+		// Make sure that the color doesn't leak into the ellipsis and the next lines:
+		b.WriteString(colorReset)
+	}
+	b.WriteString(ellipsis)
+	return b.String()
+}
+
+// lineWidth returns the number of columns the line takes in a terminal, expanding tabs and ignoring
+// color escape sequences.
+func lineWidth(line string) int {
+	column := 0
+	escape := false
+	for _, r := range line {
+		column, escape = advanceColumn(column, escape, r)
+	}
+	return column
+}
+
+// advanceColumn returns the column after writing the rune, and whether the rune is part of an escape
+// sequence, which doesn't take any column.
+func advanceColumn(column int, escape bool, r rune) (int, bool) {
+	switch {
+	case escape:
+		// Escape sequences end with a letter:
+		return column, !unicode.IsLetter(r)
+	case r == '\x1b':
+		return column, true
+	case r == '\t':
+		return (column/tabWidth + 1) * tabWidth, false
+	default:
+		return column + 1, false
+	}
+}
+
+// printState returns the state of the cluster highlighted with a color that reflects its health:
+// green when it is ready, red when it failed and yellow when it is transitioning.
+func printState(state cmv1.ClusterState, noColor bool) string {
+	var attribute color.Attribute
+	switch state {
+	case cmv1.ClusterStateReady:
+		attribute = color.FgGreen
+	case cmv1.ClusterStateError:
+		attribute = color.FgRed
+	case cmv1.ClusterStateInstalling, cmv1.ClusterStatePending, cmv1.ClusterStateValidating,
+		cmv1.ClusterStateWaiting, cmv1.ClusterStateHibernating, cmv1.ClusterStatePoweringDown,
+		cmv1.ClusterStateResuming, cmv1.ClusterStateUninstalling:
+		attribute = color.FgYellow
+	default:
+		return string(state)
+	}
+	stateColor := color.New(attribute)
+	if noColor {
+		stateColor.DisableColor()
+	}
+	return stateColor.Sprint(state)
+}
+
+// findAdminIdentityProvider returns a description of the identity provider holding the cluster
+// administrator user, or 'none' when there isn't one. When the identity providers can't be read it
+// returns an empty string together with the error.
+func findAdminIdentityProvider(conn *sdk.Connection, cluster *cmv1.Cluster) (string, error) {
+	idps, err := GetIdentityProviders(conn.ClustersMgmt().V1().Clusters(), cluster.ID())
+	if err != nil {
+		return "", fmt.Errorf("can't get identity providers of cluster '%s': %v", cluster.ID(), err)
+	}
+
+	for _, idp := range idps {
+		if idp.Name() == adminIdentityProviderName && idp.Type() == cmv1.IdentityProviderTypeHtpasswd {
+			return fmt.Sprintf("%s (htpasswd)", idp.Name()), nil
+		}
+	}
+
+	return "none", nil
+}
+
 // findHyperShiftMgmtSvcClusters returns the name of a HyperShift cluster's management and service clusters.
-// It essentially ignores error as these endpoint is behind specific permissions by returning empty strings when any
-// errors are encountered, which results in them not being printed in the output.
-func findHyperShiftMgmtSvcClusters(conn *sdk.Connection, cluster *cmv1.Cluster) (string, string) {
+// These endpoints are behind specific permissions, so unless in strict mode callers ignore the error and
+// don't print the names that couldn't be found.
+func findHyperShiftMgmtSvcClusters(conn *sdk.Connection, cluster *cmv1.Cluster) (string, string, error) {
 	if !cluster.Hypershift().Enabled() {
-		return "", ""
+		return "", "", nil
 	}
 
 	hypershiftResp, err := conn.ClustersMgmt().V1().Clusters().
@@ -336,24 +920,75 @@ func findHyperShiftMgmtSvcClusters(conn *sdk.Connection, cluster *cmv1.Cluster)
 		Get().
 		Send()
 	if err != nil {
-		return "", ""
+		return "", "", fmt.Errorf("can't get HyperShift details of cluster '%s': %v", cluster.ID(), err)
 	}
 
 	mgmtClusterName := hypershiftResp.Body().ManagementCluster()
 	fmMgmtResp, err := conn.OSDFleetMgmt().V1().ManagementClusters().
 		List().
-		Parameter("search", fmt.Sprintf("name='%s'", mgmtClusterName)).
+		Parameter("search", fmt.Sprintf("name=%s", ocm.QuoteSearchValue(mgmtClusterName))).
+		Send()
+	if err != nil {
+		return mgmtClusterName, "", fmt.Errorf("can't get management cluster '%s': %v",
+			mgmtClusterName, err)
+	}
+
+	return mgmtClusterName, findServiceClusterName(fmMgmtResp.Items()), nil
+}
+
+// findProvisionShard retrieves the provision shard of the cluster. It returns nil together with the
+// error when it can't be retrieved, so that callers that don't care about the error can still show
+// empty details.
+func findProvisionShard(conn *sdk.Connection, cluster *cmv1.Cluster) (*cmv1.ProvisionShard, error) {
+	response, err := conn.ClustersMgmt().V1().Clusters().
+		Cluster(cluster.ID()).
+		ProvisionShard().
+		Get().
 		Send()
 	if err != nil {
-		return mgmtClusterName, ""
+		return nil, fmt.Errorf("can't get provision shard of cluster '%s': %v", cluster.ID(), err)
+	}
+	return response.Body(), nil
+}
+
+// findServiceClusterName returns the name of the service cluster that is the parent of the first
+// management cluster of the list, or an empty string if there is none.
+func findServiceClusterName(mgmtClusters *fmv1.ManagementClusterList) string {
+	if mgmtClusters.Len() == 0 {
+		return ""
 	}
 
-	if kind := fmMgmtResp.Items().Get(0).Parent().Kind(); kind == "ServiceCluster" {
-		return mgmtClusterName, fmMgmtResp.Items().Get(0).Parent().Name()
+	parent := mgmtClusters.Get(0).Parent()
+	if parent != nil && parent.Kind() == "ServiceCluster" {
+		return parent.Name()
 	}
 
 	// Shouldn't normally happen as every management cluster should have a service cluster
-	return mgmtClusterName, ""
+	return ""
+}
+
+// isMarketplaceBilling returns true when the cluster is billed through a cloud marketplace.
+func isMarketplaceBilling(cluster *cmv1.Cluster) bool {
+	return strings.HasPrefix(string(cluster.BillingModel()), "marketplace")
+}
+
+// findReservedResources returns the quota reserved by a subscription. Unless in strict mode callers
+// ignore the error and don't print the section.
+func findReservedResources(conn *sdk.Connection, subID string) ([]*amv1.ReservedResource, error) {
+	if subID == "" {
+		return nil, nil
+	}
+
+	response, err := conn.AccountsMgmt().V1().Subscriptions().
+		Subscription(subID).
+		ReservedResources().
+		List().
+		Send()
+	if err != nil {
+		return nil, fmt.Errorf("can't get reserved resources of subscription '%s': %v", subID, err)
+	}
+
+	return response.Items().Slice(), nil
 }
 
 func PrintClusterWarnings(connection *sdk.Connection, cluster *cmv1.Cluster) error {
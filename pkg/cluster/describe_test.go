@@ -1,8 +1,21 @@
 package cluster
 
 import (
-	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	. "github.com/onsi/gomega" // nolint
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	fmv1 "github.com/openshift-online/ocm-sdk-go/osdfleetmgmt/v1"
+	sdktesting "github.com/openshift-online/ocm-sdk-go/testing"
 )
 
 // newTestCluster assembles a *cmv1.Cluster while handling the error to help out with inline test-case generation
@@ -29,7 +42,10 @@ func TestFindHyperShiftMgmtSvcClusters(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		mgmt, svc := findHyperShiftMgmtSvcClusters(nil, test.cluster)
+		mgmt, svc, err := findHyperShiftMgmtSvcClusters(nil, test.cluster)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
 		if test.expectedMgmt != mgmt {
 			t.Errorf("expected %s, got %s", test.expectedMgmt, mgmt)
 		}
@@ -38,3 +54,650 @@ func TestFindHyperShiftMgmtSvcClusters(t *testing.T) {
 		}
 	}
 }
+
+func TestFindServiceClusterName(t *testing.T) {
+	tests := []struct {
+		name     string
+		builder  *fmv1.ManagementClusterListBuilder
+		expected string
+	}{
+		{
+			name:    "Empty fleet manager response",
+			builder: fmv1.NewManagementClusterList(),
+		},
+		{
+			name:    "Management cluster without parent",
+			builder: fmv1.NewManagementClusterList().Items(fmv1.NewManagementCluster().Name("mgmt")),
+		},
+		{
+			name: "Management cluster with service cluster parent",
+			builder: fmv1.NewManagementClusterList().Items(fmv1.NewManagementCluster().Name("mgmt").
+				Parent(fmv1.NewManagementClusterParent().Kind("ServiceCluster").Name("svc"))),
+			expected: "svc",
+		},
+	}
+
+	for _, test := range tests {
+		mgmtClusters, err := test.builder.Build()
+		if err != nil {
+			t.Fatalf("failed to build management cluster list: %s", err)
+		}
+		svc := findServiceClusterName(mgmtClusters)
+		if test.expected != svc {
+			t.Errorf("%s: expected %s, got %s", test.name, test.expected, svc)
+		}
+	}
+}
+
+func TestEvaluateEligibility(t *testing.T) {
+	hibernatable, err := amv1.NewSubscription().
+		Capabilities(amv1.NewCapability().Name(hibernateClusterCapability).Value("true").Inherited(true)).
+		Build()
+	if err != nil {
+		t.Fatalf("failed to build subscription: %s", err)
+	}
+
+	tests := []struct {
+		name     string
+		cluster  *cmv1.Cluster
+		sub      *amv1.Subscription
+		expected map[string]bool
+	}{
+		{
+			name: "Ready with upgrades",
+			cluster: newTestCluster(t, cmv1.NewCluster().
+				State(cmv1.ClusterStateReady).
+				Version(cmv1.NewVersion().AvailableUpgrades("4.14.1"))),
+			sub:      hibernatable,
+			expected: map[string]bool{"upgrade": true, "hibernate": true, "scale": true, "delete": true},
+		},
+		{
+			name: "Ready without hibernation capability",
+			cluster: newTestCluster(t, cmv1.NewCluster().
+				State(cmv1.ClusterStateReady)),
+			expected: map[string]bool{"upgrade": false, "hibernate": false, "scale": true, "delete": true},
+		},
+		{
+			name: "Ready HyperShift in limited support",
+			cluster: newTestCluster(t, cmv1.NewCluster().
+				State(cmv1.ClusterStateReady).
+				Hypershift(cmv1.NewHypershift().Enabled(true)).
+				Status(cmv1.NewClusterStatus().LimitedSupportReasonCount(1)).
+				Version(cmv1.NewVersion().AvailableUpgrades("4.14.1"))),
+			sub:      hibernatable,
+			expected: map[string]bool{"upgrade": false, "hibernate": false, "scale": true, "delete": true},
+		},
+		{
+			name: "Uninstalling",
+			cluster: newTestCluster(t, cmv1.NewCluster().
+				State(cmv1.ClusterStateUninstalling)),
+			sub:      hibernatable,
+			expected: map[string]bool{"upgrade": false, "hibernate": false, "scale": false, "delete": false},
+		},
+		{
+			name: "Delete protection",
+			cluster: newTestCluster(t, cmv1.NewCluster().
+				State(cmv1.ClusterStateReady).
+				DeleteProtection(cmv1.NewDeleteProtection().Enabled(true))),
+			sub:      hibernatable,
+			expected: map[string]bool{"upgrade": false, "hibernate": true, "scale": true, "delete": false},
+		},
+	}
+
+	for _, test := range tests {
+		for _, eligibility := range evaluateEligibility(test.cluster, test.sub) {
+			if test.expected[eligibility.Action] != eligibility.Eligible {
+				t.Errorf("%s: expected %s eligibility to be %t, got %t (%s)", test.name, eligibility.Action,
+					test.expected[eligibility.Action], eligibility.Eligible, eligibility.Reason)
+			}
+			if !eligibility.Eligible && eligibility.Reason == "" {
+				t.Errorf("%s: expected a reason for %s not being eligible", test.name, eligibility.Action)
+			}
+		}
+	}
+}
+
+func TestTruncateLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		width    int
+		expected string
+	}{
+		{
+			name:     "No width",
+			text:     "ID:\t\t\t1234567890",
+			width:    0,
+			expected: "ID:\t\t\t1234567890",
+		},
+		{
+			name:     "Short lines",
+			text:     "abc\ndef\n",
+			width:    3,
+			expected: "abc\ndef\n",
+		},
+		{
+			name:     "Long line",
+			text:     "abc\nabcdefghij\n",
+			width:    5,
+			expected: "abc\nabcd…\n",
+		},
+		{
+			name:     "Color escape sequences take no columns",
+			text:     "State:\t\t\t\x1b[32mready\x1b[0m",
+			width:    29,
+			expected: "State:\t\t\t\x1b[32mready\x1b[0m",
+		},
+		{
+			name:     "Color is reset when truncating",
+			text:     "State:\t\t\t\x1b[32mready\x1b[0m",
+			width:    27,
+			expected: "State:\t\t\t\x1b[32mre\x1b[0m…",
+		},
+		{
+			name:     "Tabs are expanded",
+			text:     "ID:\t\t\t1234567890",
+			width:    26,
+			expected: "ID:\t\t\t1…",
+		},
+	}
+
+	for _, test := range tests {
+		actual := truncateLines(test.text, test.width)
+		if test.expected != actual {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestPrintComputeReplicas(t *testing.T) {
+	tests := []struct {
+		name     string
+		cluster  *cmv1.Cluster
+		expected string
+	}{
+		{
+			name:     "No nodes",
+			cluster:  newTestCluster(t, cmv1.NewCluster()),
+			expected: notAvailable,
+		},
+		{
+			name:     "Fixed compute",
+			cluster:  newTestCluster(t, cmv1.NewCluster().Nodes(cmv1.NewClusterNodes().Compute(3))),
+			expected: "3",
+		},
+		{
+			name: "Autoscaled compute",
+			cluster: newTestCluster(t, cmv1.NewCluster().Nodes(cmv1.NewClusterNodes().
+				AutoscaleCompute(cmv1.NewMachinePoolAutoscaling().MinReplicas(2).MaxReplicas(6)))),
+			expected: "2-6 (Autoscaled)",
+		},
+	}
+
+	for _, test := range tests {
+		actual := printComputeReplicas(test.cluster.Nodes())
+		if test.expected != actual {
+			t.Errorf("%s: expected %s, got %s", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestNewOwnerInfo(t *testing.T) {
+	sub, err := amv1.NewSubscription().DisplayName("my-cluster").Build()
+	if err != nil {
+		t.Fatalf("failed to build subscription: %s", err)
+	}
+	account, err := amv1.NewAccount().
+		Username("user").
+		Email("user@example.com").
+		Organization(amv1.NewOrganization().Name("My Org").EbsAccountID("12345")).
+		Build()
+	if err != nil {
+		t.Fatalf("failed to build account: %s", err)
+	}
+
+	tests := []struct {
+		name     string
+		sub      *amv1.Subscription
+		account  *amv1.Account
+		expected ownerInfo
+	}{
+		{
+			name: "Nil subscription and account",
+			expected: ownerInfo{
+				DisplayName:   notAvailable,
+				Organization:  notAvailable,
+				Creator:       notAvailable,
+				Email:         notAvailable,
+				AccountNumber: notAvailable,
+			},
+		},
+		{
+			name: "Nil account",
+			sub:  sub,
+			expected: ownerInfo{
+				DisplayName:   "my-cluster",
+				Organization:  notAvailable,
+				Creator:       notAvailable,
+				Email:         notAvailable,
+				AccountNumber: notAvailable,
+			},
+		},
+		{
+			name:    "Subscription and account",
+			sub:     sub,
+			account: account,
+			expected: ownerInfo{
+				DisplayName:   "my-cluster",
+				Organization:  "My Org",
+				Creator:       "user",
+				Email:         "user@example.com",
+				AccountNumber: "12345",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		actual := newOwnerInfo(test.sub, test.account)
+		if test.expected != actual {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestPrintComputeMachineInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		cluster  *cmv1.Cluster
+		expected string
+	}{
+		{
+			name:     "No nodes",
+			cluster:  newTestCluster(t, cmv1.NewCluster()),
+			expected: "",
+		},
+		{
+			name: "AWS",
+			cluster: newTestCluster(t, cmv1.NewCluster().Nodes(cmv1.NewClusterNodes().
+				ComputeMachineType(cmv1.NewMachineType().ID("m5.xlarge")).
+				ComputeRootVolume(cmv1.NewRootVolume().AWS(cmv1.NewAWSVolume().Size(300))))),
+			expected: "\n\tInstance Type: m5.xlarge\n\tRoot Volume Size: 300 GiB",
+		},
+		{
+			name: "GCP without root volume",
+			cluster: newTestCluster(t, cmv1.NewCluster().Nodes(cmv1.NewClusterNodes().
+				ComputeMachineType(cmv1.NewMachineType().ID("custom-4-16384")))),
+			expected: "\n\tInstance Type: custom-4-16384",
+		},
+	}
+
+	for _, test := range tests {
+		actual := printComputeMachineInfo(test.cluster.Nodes())
+		if test.expected != actual {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestPrintStateWithoutColor(t *testing.T) {
+	for _, state := range []cmv1.ClusterState{
+		cmv1.ClusterStateReady,
+		cmv1.ClusterStateError,
+		cmv1.ClusterStateInstalling,
+		cmv1.ClusterStateUnknown,
+	} {
+		actual := printState(state, true)
+		if string(state) != actual {
+			t.Errorf("expected %s, got %q", state, actual)
+		}
+	}
+}
+
+func TestIsCreatedBy(t *testing.T) {
+	account, err := amv1.NewAccount().
+		Username("user").
+		Email("user@example.com").
+		Build()
+	if err != nil {
+		t.Fatalf("failed to build account: %s", err)
+	}
+
+	tests := []struct {
+		name     string
+		account  *amv1.Account
+		creator  string
+		expected bool
+	}{
+		{
+			name:     "Matches user name",
+			account:  account,
+			creator:  "user",
+			expected: true,
+		},
+		{
+			name:     "Matches email ignoring case",
+			account:  account,
+			creator:  "User@Example.com",
+			expected: true,
+		},
+		{
+			name:     "Different user",
+			account:  account,
+			creator:  "other",
+			expected: false,
+		},
+		{
+			name:     "Account not available",
+			creator:  "user",
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		actual := isCreatedBy(test.account, test.creator)
+		if test.expected != actual {
+			t.Errorf("%s: expected %t, got %t", test.name, test.expected, actual)
+		}
+	}
+}
+
+func TestRenderOrganizationClusters(t *testing.T) {
+	// The first page is full, so a second one is requested. All the clusters of the first page
+	// are created by the same user, whose account is retrieved only once. The cluster of the
+	// second page is created by a user whose account can't be read:
+	var lock sync.Mutex
+	requests := map[string]int{}
+	connection := newTestHandlerConnection(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests[r.URL.Path]++
+		lock.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/accounts_mgmt/v1/subscriptions":
+			var items []string
+			if r.URL.Query().Get("page") == "1" {
+				for i := 0; i < 100; i++ {
+					items = append(items, fmt.Sprintf(
+						`{"kind": "Subscription", "cluster_id": "cluster-%d", "display_name": "name-%d", `+
+							`"status": "Active", "creator": {"kind": "Account", "id": "readable"}}`,
+						i, i,
+					))
+				}
+			} else {
+				items = append(items,
+					`{"kind": "Subscription", "cluster_id": "cluster-100", "display_name": "name-100", `+
+						`"status": "Active", "creator": {"kind": "Account", "id": "forbidden"}}`,
+				)
+			}
+			fmt.Fprintf(w, `{"kind": "SubscriptionList", "size": %d, "items": [%s]}`,
+				len(items), strings.Join(items, ", "))
+		case "/api/accounts_mgmt/v1/accounts/readable":
+			fmt.Fprint(w, `{"kind": "Account", "id": "readable", "username": "user", "email": "user@example.com"}`)
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, errorBody(http.StatusForbidden))
+		}
+	}))
+
+	text, err := RenderOrganizationClusters(connection, "123", "")
+	if err != nil {
+		t.Fatalf("failed to render organization clusters: %s", err)
+	}
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	if len(lines) != 102 {
+		t.Fatalf("expected a header and 101 clusters, got %d lines", len(lines))
+	}
+	if fields := strings.Fields(lines[1]); fields[3] != "user" || fields[4] != "user@example.com" {
+		t.Errorf("expected the creator of the first cluster to be readable, got %q", lines[1])
+	}
+	if fields := strings.Fields(lines[101]); fields[3] != notAvailable || fields[4] != notAvailable {
+		t.Errorf("expected the creator of the last cluster to be %s, got %q", notAvailable, lines[101])
+	}
+	if requests["/api/accounts_mgmt/v1/subscriptions"] != 2 {
+		t.Errorf("expected 2 pages of subscriptions, got %d", requests["/api/accounts_mgmt/v1/subscriptions"])
+	}
+	if requests["/api/accounts_mgmt/v1/accounts/readable"] != 1 {
+		t.Errorf("expected the account to be retrieved once, got %d",
+			requests["/api/accounts_mgmt/v1/accounts/readable"])
+	}
+
+	text, err = RenderOrganizationClusters(connection, "123", "user@example.com")
+	if err != nil {
+		t.Fatalf("failed to render organization clusters: %s", err)
+	}
+	lines = strings.Split(strings.TrimSpace(text), "\n")
+	if len(lines) != 101 {
+		t.Errorf("expected a header and the 100 clusters created by the user, got %d lines", len(lines))
+	}
+}
+
+// newTestConnection starts a server that responds to every request with the given status and body, and
+// returns a connection to it.
+func newTestConnection(t *testing.T, status int, body string) *sdk.Connection {
+	return newTestHandlerConnection(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	}))
+}
+
+// newTestHandlerConnection starts a server that uses the given handler, and returns a connection to it.
+func newTestHandlerConnection(t *testing.T, handler http.Handler) *sdk.Connection {
+	RegisterTestingT(t)
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	connection, err := sdk.NewConnectionBuilder().
+		URL(server.URL).
+		Tokens(sdktesting.MakeTokenString("Bearer", 15*time.Minute)).
+		Build()
+	if err != nil {
+		t.Fatalf("failed to create connection: %s", err)
+	}
+	t.Cleanup(func() {
+		connection.Close()
+	})
+	return connection
+}
+
+func errorBody(status int) string {
+	return fmt.Sprintf(`{"kind": "Error", "id": "%d", "reason": "Test error"}`, status)
+}
+
+func TestFetchSubscription(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		expectedID  string
+		expectedErr bool
+	}{
+		{
+			name:       "Found",
+			status:     http.StatusOK,
+			body:       `{"kind": "Subscription", "id": "123"}`,
+			expectedID: "123",
+		},
+		{
+			name:   "Not found",
+			status: http.StatusNotFound,
+			body:   errorBody(http.StatusNotFound),
+		},
+		{
+			name:   "Forbidden",
+			status: http.StatusForbidden,
+			body:   errorBody(http.StatusForbidden),
+		},
+		{
+			name:        "Server error",
+			status:      http.StatusInternalServerError,
+			body:        errorBody(http.StatusInternalServerError),
+			expectedErr: true,
+		},
+	}
+	for _, test := range tests {
+		connection := newTestConnection(t, test.status, test.body)
+		sub, err := fetchSubscription(connection, "123")
+		if test.expectedErr != (err != nil) {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if test.expectedID != sub.ID() {
+			t.Errorf("%s: expected subscription '%s', got '%s'", test.name, test.expectedID, sub.ID())
+		}
+	}
+}
+
+func TestFetchAccount(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		expectedID  string
+		expectedErr bool
+	}{
+		{
+			name:       "Found",
+			status:     http.StatusOK,
+			body:       `{"kind": "Account", "id": "456"}`,
+			expectedID: "456",
+		},
+		{
+			name:   "Not found",
+			status: http.StatusNotFound,
+			body:   errorBody(http.StatusNotFound),
+		},
+		{
+			name:   "Forbidden",
+			status: http.StatusForbidden,
+			body:   errorBody(http.StatusForbidden),
+		},
+		{
+			name:        "Server error",
+			status:      http.StatusInternalServerError,
+			body:        errorBody(http.StatusInternalServerError),
+			expectedErr: true,
+		},
+	}
+	for _, test := range tests {
+		connection := newTestConnection(t, test.status, test.body)
+		account, err := fetchAccount(connection, "456")
+		if test.expectedErr != (err != nil) {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if test.expectedID != account.ID() {
+			t.Errorf("%s: expected account '%s', got '%s'", test.name, test.expectedID, account.ID())
+		}
+	}
+}
+
+func TestFetchWithoutIdentifier(t *testing.T) {
+	sub, err := fetchSubscription(nil, "")
+	if sub != nil || err != nil {
+		t.Errorf("expected no subscription and no error, got %v and %v", sub, err)
+	}
+	account, err := fetchAccount(nil, "")
+	if account != nil || err != nil {
+		t.Errorf("expected no account and no error, got %v and %v", account, err)
+	}
+}
+
+func TestRenderClusterDescriptionJSON(t *testing.T) {
+	cluster := newTestCluster(t, cmv1.NewCluster().ID("123"))
+	resource, err := amv1.NewReservedResource().
+		ResourceType("cluster").
+		ResourceName("compute.node").
+		Count(3).
+		Build()
+	if err != nil {
+		t.Fatalf("failed to build reserved resource: %s", err)
+	}
+
+	text, err := renderClusterDescriptionJSON(cluster, nil, nil, clusterDescription{
+		ClusterAdmin:          true,
+		ClusterAdminSource:    "CCS",
+		AdminIdentityProvider: "cluster-admin (htpasswd)",
+		MarketplaceAccount:    "456",
+		ShardID:               "shard-1",
+		ReservedResources:     newReservedResourceDescriptions([]*amv1.ReservedResource{resource}),
+		LimitedSupportReasons: []*lmtSprReasonItem{{ID: "789", Summary: "Degraded"}},
+		Eligibility:           []actionEligibility{{Action: "scale", Eligible: true}},
+	})
+	if err != nil {
+		t.Fatalf("failed to render description: %s", err)
+	}
+
+	var description map[string]interface{}
+	err = json.Unmarshal([]byte(text), &description)
+	if err != nil {
+		t.Fatalf("failed to parse description: %s", err)
+	}
+	expected := map[string]string{
+		"cluster_admin_source":    "CCS",
+		"admin_identity_provider": "cluster-admin (htpasswd)",
+		"marketplace_account":     "456",
+		"shard_id":                "shard-1",
+	}
+	for key, value := range expected {
+		if description[key] != value {
+			t.Errorf("expected %s to be %q, got %v", key, value, description[key])
+		}
+	}
+	if description["cluster"].(map[string]interface{})["id"] != "123" {
+		t.Errorf("expected the cluster to be included, got %v", description["cluster"])
+	}
+	resources := description["reserved_resources"].([]interface{})
+	if len(resources) != 1 || resources[0].(map[string]interface{})["count"] != float64(3) {
+		t.Errorf("expected one reserved resource with count 3, got %v", resources)
+	}
+	for _, key := range []string{"display_name", "organization", "creator", "email", "account_number"} {
+		if _, ok := description[key]; ok {
+			t.Errorf("expected %s to be omitted, got %v", key, description[key])
+		}
+	}
+	if _, ok := description["machine_pools"]; ok {
+		t.Errorf("expected no machine pools, got %v", description["machine_pools"])
+	}
+	reasons := description["limited_support_reasons"].([]interface{})
+	if len(reasons) != 1 || reasons[0].(map[string]interface{})["summary"] != "Degraded" {
+		t.Errorf("expected one limited support reason, got %v", reasons)
+	}
+	if len(description["eligibility"].([]interface{})) != 1 {
+		t.Errorf("expected one eligibility entry, got %v", description["eligibility"])
+	}
+}
+
+func TestRenderClusterDescriptionStrict(t *testing.T) {
+	cluster := newTestCluster(t, cmv1.NewCluster().ID("123"))
+
+	tests := []struct {
+		name        string
+		strict      bool
+		expectedErr bool
+	}{
+		{
+			name:   "Lenient",
+			strict: false,
+		},
+		{
+			name:        "Strict",
+			strict:      true,
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		// The provision shard is the only detail retrieved for this cluster:
+		connection := newTestConnection(t, http.StatusForbidden, errorBody(http.StatusForbidden))
+		text, err := RenderClusterDescription(connection, cluster, DescribeOptions{Strict: test.strict})
+		if test.expectedErr {
+			if err == nil || !strings.Contains(err.Error(), "provision shard") {
+				t.Errorf("%s: expected a provision shard error, got %v", test.name, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+		}
+		if !strings.Contains(text, "ID:			123\n") || strings.Contains(text, "Shard:") {
+			t.Errorf("%s: expected the description without the shard, got %q", test.name, text)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// actionEligibility describes whether an action can currently be performed on a cluster.
+type actionEligibility struct {
+	Action   string `json:"action"`
+	Eligible bool   `json:"eligible"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// evaluateEligibility evaluates the state of the cluster and the capabilities of its subscription to
+// determine which of the common actions can be performed on it. The rules are described in the help
+// of the --show-eligibility flag of the describe cluster command, keep them in sync.
+func evaluateEligibility(cluster *cmv1.Cluster, sub *amv1.Subscription) []actionEligibility {
+	ready := cluster.State() == cmv1.ClusterStateReady
+	notReadyReason := fmt.Sprintf("cluster is %s", cluster.State())
+
+	upgrade := actionEligibility{Action: "upgrade"}
+	switch {
+	case !ready:
+		upgrade.Reason = notReadyReason
+	case cluster.Status().LimitedSupportReasonCount() > 0:
+		upgrade.Reason = "cluster is in limited support"
+	case len(cluster.Version().AvailableUpgrades()) == 0:
+		upgrade.Reason = "no upgrades available"
+	default:
+		upgrade.Eligible = true
+	}
+
+	hibernate := actionEligibility{Action: "hibernate"}
+	switch {
+	case !ready:
+		hibernate.Reason = notReadyReason
+	case cluster.Hypershift().Enabled():
+		hibernate.Reason = "clusters with a hosted control plane can't be hibernated"
+	case !hasCapability(sub, hibernateClusterCapability):
+		hibernate.Reason = "organization doesn't have the hibernation capability"
+	default:
+		hibernate.Eligible = true
+	}
+
+	scale := actionEligibility{Action: "scale"}
+	if ready {
+		scale.Eligible = true
+	} else {
+		scale.Reason = notReadyReason
+	}
+
+	remove := actionEligibility{Action: "delete"}
+	switch {
+	case cluster.State() == cmv1.ClusterStateUninstalling:
+		remove.Reason = "cluster is already uninstalling"
+	case cluster.DeleteProtection().Enabled():
+		remove.Reason = "delete protection is enabled"
+	default:
+		remove.Eligible = true
+	}
+
+	return []actionEligibility{upgrade, hibernate, scale, remove}
+}
+
+func printEligibility(eligibility actionEligibility) string {
+	if eligibility.Eligible {
+		return fmt.Sprintf("\t%s:\tyes", eligibility.Action)
+	}
+	return fmt.Sprintf("\t%s:\tno (%s)", eligibility.Action, eligibility.Reason)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	amv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+
+	"github.com/openshift-online/ocm-cli/pkg/ocm"
+)
+
+// RenderOrganizationClusters returns a condensed description of the clusters of an organization, one
+// line per cluster, including the user that created it. When the creator is given only the clusters
+// created by the user with that user name or email address are included.
+func RenderOrganizationClusters(connection *sdk.Connection, orgID string,
+	creator string) (string, error) {
+	var b strings.Builder
+	writer := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "ID\tNAME\tSTATUS\tCREATOR\tEMAIL\n")
+
+	// Accounts are cached because the same user usually creates many clusters:
+	accounts := map[string]*amv1.Account{}
+
+	query := fmt.Sprintf(
+		"organization_id = %s and cluster_id != '' and "+
+			"status != 'Archived' and status != 'Deprovisioned'",
+		ocm.QuoteSearchValue(orgID),
+	)
+	size := 100
+	index := 1
+	for {
+		response, err := connection.AccountsMgmt().V1().Subscriptions().List().
+			Search(query).
+			Size(size).
+			Page(index).
+			Send()
+		if err != nil {
+			return "", fmt.Errorf("can't retrieve clusters of organization '%s': %v", orgID, err)
+		}
+
+		for _, sub := range response.Items().Slice() {
+			accountID := sub.Creator().ID()
+			account, ok := accounts[accountID]
+			if !ok {
+				account, err = fetchAccount(connection, accountID)
+				if err != nil {
+					return "", err
+				}
+				accounts[accountID] = account
+			}
+			if creator != "" && !isCreatedBy(account, creator) {
+				continue
+			}
+			owner := newOwnerInfo(sub, account)
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n",
+				sub.ClusterID(),
+				owner.DisplayName,
+				sub.Status(),
+				owner.Creator,
+				owner.Email,
+			)
+		}
+
+		if response.Size() < size {
+			break
+		}
+		index++
+	}
+
+	err := writer.Flush()
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// isCreatedBy checks if the given account corresponds to the creator, given as a user name or as an
+// email address. Accounts that couldn't be retrieved never match.
+func isCreatedBy(account *amv1.Account, creator string) bool {
+	if account == nil {
+		return false
+	}
+	return account.Username() == creator || strings.EqualFold(account.Email(), creator)
+}
@@ -24,6 +24,8 @@ import (
 	goVersion "github.com/hashicorp/go-version"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift-online/ocm-cli/pkg/ocm"
 )
 
 const prefix = "openshift-v"
@@ -49,10 +51,11 @@ func GetEnabledVersions(client *cmv1.Client, channelGroup string, gcpMarketplace
 	size := 100
 	filter := "enabled = 'true'"
 	if gcpMarketplaceEnabled != "" {
-		filter = fmt.Sprintf("%s AND gcp_marketplace_enabled = '%s'", filter, gcpMarketplaceEnabled)
+		filter = fmt.Sprintf("%s AND gcp_marketplace_enabled = %s", filter,
+			ocm.QuoteSearchValue(gcpMarketplaceEnabled))
 	}
 	if channelGroup != "" {
-		filter = fmt.Sprintf("%s AND channel_group = '%s'", filter, channelGroup)
+		filter = fmt.Sprintf("%s AND channel_group = %s", filter, ocm.QuoteSearchValue(channelGroup))
 	}
 	for {
 		response, err := collection.List().
@@ -285,7 +285,11 @@ func (c *Config) Connection() (connection *sdk.Connection, err error) {
 	// values in the configuration, so that default values won't be overridden:
 	builder := sdk.NewConnectionBuilder()
 	builder.Logger(logger)
-	builder.Agent("OCM-CLI/" + info.Version)
+	agent := "OCM-CLI/" + info.Version
+	if extra := os.Getenv(properties.UserAgentEnvKey); extra != "" {
+		agent += " " + extra
+	}
+	builder.Agent(agent)
 	if c.TokenURL != "" {
 		builder.TokenURL(c.TokenURL)
 	}
@@ -17,12 +17,18 @@ limitations under the License.
 package config
 
 import (
+	"net/http"
+	"os"
 	"time"
 
-	. "github.com/onsi/ginkgo/v2" // nolint
-	. "github.com/onsi/gomega"    // nolint
+	. "github.com/onsi/ginkgo/v2"    // nolint
+	. "github.com/onsi/gomega"       // nolint
+	. "github.com/onsi/gomega/ghttp" // nolint
 
 	. "github.com/openshift-online/ocm-sdk-go/testing" // nolint
+
+	"github.com/openshift-online/ocm-cli/pkg/info"
+	"github.com/openshift-online/ocm-cli/pkg/properties"
 )
 
 var _ = Describe("Armed", func() {
@@ -171,3 +177,70 @@ var _ = Describe("Armed", func() {
 		Expect(reason).To(Equal("credentials aren't set"))
 	})
 })
+
+var _ = Describe("Connection", func() {
+	var apiServer *Server
+	var config *Config
+	var savedAgent string
+	var hadAgent bool
+
+	BeforeEach(func() {
+		// Save the user agent environment variable, so that it can be restored:
+		savedAgent, hadAgent = os.LookupEnv(properties.UserAgentEnvKey)
+
+		// Create the server:
+		apiServer = MakeTCPServer()
+
+		// Create the configuration:
+		config = &Config{
+			AccessToken: MakeTokenString("Bearer", 15*time.Minute),
+			URL:         apiServer.URL(),
+			TokenURL:    "http://my-sso.example.com",
+		}
+	})
+
+	AfterEach(func() {
+		// Close the server:
+		apiServer.Close()
+
+		// Restore the user agent environment variable:
+		if hadAgent {
+			os.Setenv(properties.UserAgentEnvKey, savedAgent)
+		} else {
+			os.Unsetenv(properties.UserAgentEnvKey)
+		}
+	})
+
+	// send sends a request using a connection created from the configuration, so that the
+	// server can check the headers.
+	send := func() {
+		connection, err := config.Connection()
+		Expect(err).ToNot(HaveOccurred())
+		defer connection.Close()
+		response, err := connection.Get().Path("/api/clusters_mgmt/v1").Send()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.Status()).To(Equal(http.StatusOK))
+	}
+
+	It("Sends the default user agent if the environment variable isn't set", func() {
+		os.Unsetenv(properties.UserAgentEnvKey)
+		apiServer.AppendHandlers(
+			CombineHandlers(
+				VerifyHeaderKV("User-Agent", "OCM-CLI/"+info.Version),
+				RespondWithJSON(http.StatusOK, "{}"),
+			),
+		)
+		send()
+	})
+
+	It("Appends the environment variable to the user agent", func() {
+		os.Setenv(properties.UserAgentEnvKey, "my-wrapper/1.2.3")
+		apiServer.AppendHandlers(
+			CombineHandlers(
+				VerifyHeaderKV("User-Agent", "OCM-CLI/"+info.Version+" my-wrapper/1.2.3"),
+				RespondWithJSON(http.StatusOK, "{}"),
+			),
+		)
+		send()
+	})
+})
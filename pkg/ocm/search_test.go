@@ -0,0 +1,32 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+  http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QuoteSearchValue", func() {
+	DescribeTable("quotes the value",
+		func(value string, expected string) {
+			Expect(QuoteSearchValue(value)).To(Equal(expected))
+		},
+		Entry("empty", "", "''"),
+		Entry("plain", "my-cluster", "'my-cluster'"),
+		Entry("single quote", "o'brien", "'o''brien'"),
+		Entry("injection attempt", "x' or name = 'y", "'x'' or name = ''y'"),
+		Entry("special characters", `a"b%c_d\e`, `'a"b%c_d\e'`),
+	)
+})
@@ -32,3 +32,21 @@ func IsTerminal(writer io.Writer) bool {
 	fd := int(file.Fd())
 	return term.IsTerminal(fd)
 }
+
+// TerminalWidth returns the width of the terminal the given writer is connected to, or zero when the
+// writer isn't a terminal or its size can't be determined
+func TerminalWidth(writer io.Writer) int {
+	file, ok := writer.(*os.File)
+	if !ok {
+		return 0
+	}
+	fd := int(file.Fd())
+	if !term.IsTerminal(fd) {
+		return 0
+	}
+	width, _, err := term.GetSize(fd)
+	if err != nil {
+		return 0
+	}
+	return width
+}
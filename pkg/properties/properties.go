@@ -17,3 +17,7 @@ limitations under the License.
 package properties
 
 const KeyringEnvKey = "OCM_KEYRING"
+
+// UserAgentEnvKey is the environment variable containing text appended to the user agent sent to
+// the OCM API, so that requests coming from wrapper tooling can be identified.
+const UserAgentEnvKey = "OCM_USER_AGENT"
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/openshift-online/ocm-cli/pkg/arguments"
+	"github.com/openshift-online/ocm-cli/pkg/ocm"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 )
 
@@ -30,7 +31,7 @@ func getMachineTypes(client *cmv1.Client, provider string) (machineTypes []*cmv1
 	for {
 		var response *cmv1.MachineTypesListResponse
 		response, err = collection.List().
-			Search(fmt.Sprintf("cloud_provider.id = '%s'", provider)).
+			Search(fmt.Sprintf("cloud_provider.id = %s", ocm.QuoteSearchValue(provider))).
 			Order("size desc").
 			Page(page).
 			Size(size).
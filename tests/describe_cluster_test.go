@@ -47,6 +47,18 @@ var _ = Describe("Describe clusters", func() {
 			Expect(getResult.ErrString()).To(ContainSubstring("identifier or external identifier is required"))
 		})
 	})
+	When("Describe with --json and a description flag", func() {
+		It("Fails", func() {
+			result := NewCommand().
+				Args(
+					"describe", "cluster", "my-cluster", "--json", "--strict",
+				).Run(ctx)
+			Expect(result.ExitCode()).ToNot(BeZero())
+			Expect(result.ErrString()).To(ContainSubstring(
+				"The --strict flag can't be used together with --json",
+			))
+		})
+	})
 	When("Describe clusters", func() {
 		var ssoServer *Server
 		var apiServer *Server
@@ -296,6 +308,23 @@ var _ = Describe("Describe clusters", func() {
 						"status": "active"
 					  }`,
 				),
+				RespondWithJSON(
+					http.StatusOK,
+					`{
+						"kind": "IdentityProviderList",
+						"page": 1,
+						"size": 1,
+						"total": 1,
+						"items": [
+						  {
+							"kind": "IdentityProvider",
+							"type": "HTPasswdIdentityProvider",
+							"id": "222",
+							"name": "cluster-admin"
+						  }
+						]
+					  }`,
+				),
 			)
 
 			// Run the command:
@@ -316,6 +345,8 @@ var _ = Describe("Describe clusters", func() {
 			Expect(result.OutString()).To(ContainSubstring("https://api.shard1.example.com:6443"))
 			Expect(result.OutString()).To(ContainSubstring("Example Org"))
 			Expect(result.OutString()).To(ContainSubstring("test@example.com"))
+			Expect(result.OutString()).To(MatchRegexp(`Cluster Admin:\s+true \(CCS\)`))
+			Expect(result.OutString()).To(ContainSubstring("Admin IDP: cluster-admin (htpasswd)"))
 
 		})
 